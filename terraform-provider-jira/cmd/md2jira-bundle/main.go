@@ -0,0 +1,51 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+// Command md2jira-bundle produces a `terraform providers mirror`-compatible
+// directory tree for registry.terraform.io/md2jira/jira across a matrix of
+// target OSes and architectures, so that Jira installs behind a firewall can
+// consume the provider via a local network_mirror instead of the public
+// registry.
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/md2jira/terraform-provider-jira/internal/bundle"
+)
+
+func main() {
+	var manifestPath string
+	var mainPkg string
+
+	flag.StringVar(&manifestPath, "manifest", "bundle.json", "path to the bundle manifest")
+	flag.StringVar(&mainPkg, "main", "github.com/md2jira/terraform-provider-jira", "package to cross-compile")
+	flag.Parse()
+
+	m, err := bundle.LoadManifest(manifestPath)
+	if err != nil {
+		log.Fatalf("md2jira-bundle: %s", err)
+	}
+
+	log.Printf("md2jira-bundle: building %s for %d version(s), %d target(s)", m.Address(), len(m.Versions), len(m.Targets))
+
+	packages, err := bundle.Build(m, mainPkg)
+	if err != nil {
+		log.Fatalf("md2jira-bundle: %s", err)
+	}
+
+	if err := bundle.WriteProviderManifests(m); err != nil {
+		log.Fatalf("md2jira-bundle: %s", err)
+	}
+
+	if err := bundle.WriteSHA256SUMS(m, packages); err != nil {
+		log.Fatalf("md2jira-bundle: %s", err)
+	}
+
+	if err := bundle.WriteMirrorIndex(m, packages); err != nil {
+		log.Fatalf("md2jira-bundle: %s", err)
+	}
+
+	log.Printf("md2jira-bundle: wrote mirror to %s", m.OutputDir)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+// Package auth resolves Jira credentials from the provider's `auth {}`
+// block, falling back through environment variables and a local profile
+// file when no block is configured, mirroring the credential chain
+// ergonomics Terraform users expect from cloud providers.
+package auth
+
+import "context"
+
+// Credentials carries whatever the configured auth method needs to
+// authenticate a Jira REST request.
+type Credentials struct {
+	// Method is one of "basic", "bearer" or "oauth2".
+	Method string
+
+	// Basic auth (email + API token).
+	Email    string
+	APIToken string
+
+	// Bearer auth (Jira Data Center Personal Access Token).
+	PAT string
+
+	// OAuth2 holds the OAuth-specific settings; nil unless Method is
+	// "oauth2".
+	OAuth2 *OAuth2Config
+}
+
+// OAuth2Config configures either the client_credentials grant or the 3LO
+// authorization_code grant with a persisted refresh token.
+type OAuth2Config struct {
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+	TokenURL     string
+
+	// TokenCachePath, when set, is where refreshed tokens are persisted so
+	// that subsequent plans don't need to re-authorize.
+	TokenCachePath string
+}
+
+// Source produces the Authorization header value to send on a Jira REST
+// request, refreshing the underlying credential if needed.
+type Source interface {
+	AuthHeader(ctx context.Context) (string, error)
+
+	// Refresh forces exactly one token refresh, called after a 401
+	// response. Implementations must be goroutine-safe and collapse
+	// concurrent calls into a single in-flight refresh.
+	Refresh(ctx context.Context) error
+}
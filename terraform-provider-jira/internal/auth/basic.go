@@ -0,0 +1,31 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+)
+
+// basicSource implements Source for email + API token (Jira Cloud) auth.
+// It never needs to refresh.
+type basicSource struct {
+	email    string
+	apiToken string
+}
+
+// NewBasic returns a Source for Jira Cloud basic auth.
+func NewBasic(email, apiToken string) Source {
+	return &basicSource{email: email, apiToken: apiToken}
+}
+
+func (s *basicSource) AuthHeader(ctx context.Context) (string, error) {
+	raw := fmt.Sprintf("%s:%s", s.email, s.apiToken)
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(raw)), nil
+}
+
+func (s *basicSource) Refresh(ctx context.Context) error {
+	return fmt.Errorf("basic auth credentials cannot be refreshed; check email/api_token")
+}
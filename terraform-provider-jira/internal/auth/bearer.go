@@ -0,0 +1,28 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package auth
+
+import (
+	"context"
+	"fmt"
+)
+
+// bearerSource implements Source for a static Personal Access Token, used
+// against Jira Data Center / Server. It never needs to refresh.
+type bearerSource struct {
+	pat string
+}
+
+// NewBearer returns a Source for a Jira Data Center Personal Access Token.
+func NewBearer(pat string) Source {
+	return &bearerSource{pat: pat}
+}
+
+func (s *bearerSource) AuthHeader(ctx context.Context) (string, error) {
+	return "Bearer " + s.pat, nil
+}
+
+func (s *bearerSource) Refresh(ctx context.Context) error {
+	return fmt.Errorf("PAT auth cannot be refreshed; issue a new token in Jira and update the provider config")
+}
@@ -0,0 +1,86 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package auth
+
+import (
+	"fmt"
+	"os"
+)
+
+// Resolve builds a Source from, in order: the explicit `auth {}` block
+// (configured), environment variables, and finally a named profile in
+// ~/.config/md2jira/credentials. The first one that yields credentials
+// wins; profile is the name selected via the provider's `profile`
+// argument, defaulting to "default".
+func Resolve(configured *Credentials, profile string) (Source, error) {
+	if configured != nil {
+		return sourceFromCredentials(*configured)
+	}
+
+	if creds := fromEnv(); creds != nil {
+		return sourceFromCredentials(*creds)
+	}
+
+	if profile == "" {
+		profile = "default"
+	}
+
+	path, err := DefaultCredentialsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := loadProfile(path, profile)
+	if err != nil {
+		return nil, err
+	}
+	if creds != nil {
+		return sourceFromCredentials(*creds)
+	}
+
+	return nil, fmt.Errorf("no Jira credentials found: set an `auth` block, JIRA_TOKEN/ATLASSIAN_API_TOKEN/JIRA_OAUTH_REFRESH_TOKEN, or add a %q profile to %s", profile, path)
+}
+
+// fromEnv checks the credential env vars Terraform users expect, in
+// priority order: a Data Center PAT, then an OAuth2 refresh token, then a
+// Cloud API token.
+func fromEnv() *Credentials {
+	if pat := os.Getenv("JIRA_TOKEN"); pat != "" {
+		return &Credentials{Method: "bearer", PAT: pat}
+	}
+
+	if refreshToken := os.Getenv("JIRA_OAUTH_REFRESH_TOKEN"); refreshToken != "" {
+		return &Credentials{
+			Method: "oauth2",
+			OAuth2: &OAuth2Config{
+				ClientID:     os.Getenv("JIRA_OAUTH_CLIENT_ID"),
+				ClientSecret: os.Getenv("JIRA_OAUTH_CLIENT_SECRET"),
+				RefreshToken: refreshToken,
+				TokenURL:     os.Getenv("JIRA_OAUTH_TOKEN_URL"),
+			},
+		}
+	}
+
+	if apiToken := os.Getenv("ATLASSIAN_API_TOKEN"); apiToken != "" {
+		return &Credentials{Method: "basic", Email: os.Getenv("JIRA_EMAIL"), APIToken: apiToken}
+	}
+
+	return nil
+}
+
+func sourceFromCredentials(creds Credentials) (Source, error) {
+	switch creds.Method {
+	case "basic":
+		return NewBasic(creds.Email, creds.APIToken), nil
+	case "bearer":
+		return NewBearer(creds.PAT), nil
+	case "oauth2":
+		if creds.OAuth2 == nil {
+			return nil, fmt.Errorf("oauth2 auth method selected but no oauth2 settings were provided")
+		}
+		return NewOAuth2(*creds.OAuth2), nil
+	default:
+		return nil, fmt.Errorf("unknown auth method %q", creds.Method)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// oauth2Source implements Source for both the client_credentials grant and
+// the 3LO authorization_code grant with a persisted refresh token. A single
+// in-flight refresh is shared across concurrent callers, so a mid-plan 401
+// from several parallel resources triggers exactly one token refresh.
+type oauth2Source struct {
+	cfg        OAuth2Config
+	httpClient *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+	refreshing  chan struct{}
+	refreshErr  error
+}
+
+// NewOAuth2 returns a Source for the given OAuth2 configuration. A non-empty
+// refresh token found at cfg.TokenCachePath takes precedence over
+// cfg.RefreshToken. If there is no refresh token either way, the
+// client_credentials grant is used; otherwise the 3LO refresh_token grant is
+// used.
+func NewOAuth2(cfg OAuth2Config) Source {
+	if cfg.TokenCachePath != "" {
+		if cached, err := os.ReadFile(cfg.TokenCachePath); err == nil {
+			if token := strings.TrimSpace(string(cached)); token != "" {
+				cfg.RefreshToken = token
+			}
+		}
+	}
+
+	return &oauth2Source{
+		cfg:        cfg,
+		httpClient: http.DefaultClient,
+	}
+}
+
+func (s *oauth2Source) AuthHeader(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	token, valid := s.accessToken, time.Now().Before(s.expiresAt)
+	s.mu.Unlock()
+
+	if !valid {
+		if err := s.Refresh(ctx); err != nil {
+			return "", err
+		}
+		s.mu.Lock()
+		token = s.accessToken
+		s.mu.Unlock()
+	}
+
+	return "Bearer " + token, nil
+}
+
+// Refresh fetches a new access token. Concurrent callers block on the same
+// in-flight request instead of each firing their own, since Jira's
+// authorization server treats a refresh token as single-use and a second
+// concurrent redemption would invalidate the first. Waiters observe the
+// same error the initiating call got, rather than assuming success.
+func (s *oauth2Source) Refresh(ctx context.Context) error {
+	s.mu.Lock()
+	if s.refreshing != nil {
+		wait := s.refreshing
+		s.mu.Unlock()
+		<-wait
+		s.mu.Lock()
+		err := s.refreshErr
+		s.mu.Unlock()
+		return err
+	}
+
+	done := make(chan struct{})
+	s.refreshing = done
+	s.mu.Unlock()
+
+	err := s.doRefresh(ctx)
+
+	s.mu.Lock()
+	s.refreshErr = err
+	s.refreshing = nil
+	s.mu.Unlock()
+	close(done)
+
+	return err
+}
+
+func (s *oauth2Source) doRefresh(ctx context.Context) error {
+	form := url.Values{}
+	form.Set("client_id", s.cfg.ClientID)
+	form.Set("client_secret", s.cfg.ClientSecret)
+
+	if s.cfg.RefreshToken != "" {
+		form.Set("grant_type", "refresh_token")
+		form.Set("refresh_token", s.cfg.RefreshToken)
+	} else {
+		form.Set("grant_type", "client_credentials")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("building oauth2 refresh request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("oauth2 refresh request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("oauth2 token endpoint returned %s", resp.Status)
+	}
+
+	var body struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return fmt.Errorf("decoding oauth2 response: %w", err)
+	}
+
+	s.mu.Lock()
+	s.accessToken = body.AccessToken
+	s.expiresAt = time.Now().Add(time.Duration(body.ExpiresIn) * time.Second)
+	s.mu.Unlock()
+
+	if body.RefreshToken != "" {
+		s.cfg.RefreshToken = body.RefreshToken
+		if s.cfg.TokenCachePath != "" {
+			if err := persistRefreshToken(s.cfg.TokenCachePath, body.RefreshToken); err != nil {
+				return fmt.Errorf("persisting refreshed token: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func persistRefreshToken(path, token string) error {
+	return os.WriteFile(path, []byte(token+"\n"), 0o600)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// TestOAuth2RefreshPropagatesErrorToWaiters reproduces a mid-plan 401 from
+// several parallel resources: only one goroutine actually redeems the
+// refresh token, but every goroutine must see the same outcome. Before the
+// fix, waiters always returned nil even when the in-flight refresh failed.
+func TestOAuth2RefreshPropagatesErrorToWaiters(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	src := NewOAuth2(OAuth2Config{
+		ClientID:     "id",
+		ClientSecret: "secret",
+		RefreshToken: "refresh",
+		TokenURL:     srv.URL,
+	}).(*oauth2Source)
+
+	const waiters = 8
+
+	var wg sync.WaitGroup
+	errs := make([]error, waiters)
+
+	for i := 0; i < waiters; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = src.Refresh(context.Background())
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err == nil {
+			t.Errorf("errs[%d] = nil, want the failed refresh's error", i)
+		}
+	}
+}
+
+// TestOAuth2RefreshSucceedsForAllWaiters is the mirror case: a successful
+// in-flight refresh must report success to every waiter too.
+func TestOAuth2RefreshSucceedsForAllWaiters(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"tok","expires_in":3600}`))
+	}))
+	defer srv.Close()
+
+	src := NewOAuth2(OAuth2Config{
+		ClientID:     "id",
+		ClientSecret: "secret",
+		RefreshToken: "refresh",
+		TokenURL:     srv.URL,
+	}).(*oauth2Source)
+
+	const waiters = 8
+
+	var wg sync.WaitGroup
+	errs := make([]error, waiters)
+
+	for i := 0; i < waiters; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = src.Refresh(context.Background())
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("errs[%d] = %v, want nil", i, err)
+		}
+	}
+}
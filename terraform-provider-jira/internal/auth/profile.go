@@ -0,0 +1,82 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package auth
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+)
+
+// profileFile is the shape of ~/.config/md2jira/credentials: a table of
+// named profiles, each describing one auth method.
+type profileFile struct {
+	Profiles map[string]profile `toml:"profiles"`
+}
+
+type profile struct {
+	Email    string `toml:"email"`
+	APIToken string `toml:"api_token"`
+	PAT      string `toml:"pat"`
+
+	OAuth2ClientID     string `toml:"oauth2_client_id"`
+	OAuth2ClientSecret string `toml:"oauth2_client_secret"`
+	OAuth2RefreshToken string `toml:"oauth2_refresh_token"`
+	OAuth2TokenURL     string `toml:"oauth2_token_url"`
+}
+
+// DefaultCredentialsPath is where named profiles are read from when no
+// `auth {}` block or credential env var is set.
+func DefaultCredentialsPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("resolving home directory: %w", err)
+	}
+
+	return filepath.Join(home, ".config", "md2jira", "credentials"), nil
+}
+
+// loadProfile reads the named profile from the TOML credentials file at
+// path. Missing files are not an error; they simply yield no credentials
+// so the chain can report a clear "nothing configured" message.
+func loadProfile(path, name string) (*Credentials, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	var pf profileFile
+	if _, err := toml.DecodeFile(path, &pf); err != nil {
+		return nil, fmt.Errorf("parsing credentials file %s: %w", path, err)
+	}
+
+	p, ok := pf.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found in %s", name, path)
+	}
+
+	return credentialsFromProfile(p), nil
+}
+
+func credentialsFromProfile(p profile) *Credentials {
+	switch {
+	case p.PAT != "":
+		return &Credentials{Method: "bearer", PAT: p.PAT}
+	case p.OAuth2RefreshToken != "":
+		return &Credentials{
+			Method: "oauth2",
+			OAuth2: &OAuth2Config{
+				ClientID:     p.OAuth2ClientID,
+				ClientSecret: p.OAuth2ClientSecret,
+				RefreshToken: p.OAuth2RefreshToken,
+				TokenURL:     p.OAuth2TokenURL,
+			},
+		}
+	case p.Email != "" && p.APIToken != "":
+		return &Credentials{Method: "basic", Email: p.Email, APIToken: p.APIToken}
+	default:
+		return nil
+	}
+}
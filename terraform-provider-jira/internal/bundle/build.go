@@ -0,0 +1,169 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package bundle
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/mod/sumdb/dirhash"
+)
+
+// Package is one cross-compiled, zipped provider artifact produced for a
+// single (version, os, arch) combination.
+type Package struct {
+	Version string
+	OS      string
+	Arch    string
+	ZipPath string
+
+	// SHA256 is the hex digest of the zip file, as published in the
+	// SHA256SUMS file alongside a public registry release.
+	SHA256 string
+
+	// DirHash is the "h1:"-prefixed Go module checksum of the zip's file
+	// list (golang.org/x/mod/sumdb/dirhash.HashZip), which is the hash
+	// format the network mirror protocol's archives[].hashes actually
+	// expects - a plain hex SHA256 with "h1:" glued on won't match a
+	// client's lock file.
+	DirHash string
+
+	Filename string
+}
+
+// Build cross-compiles the provider binary at mainPkg for every
+// version/target in the manifest, zips each into the layout Terraform
+// expects (terraform-provider-<type>_v<version>_<os>_<arch>.zip), and
+// returns the resulting packages.
+func Build(m *Manifest, mainPkg string) ([]Package, error) {
+	var packages []Package
+
+	for _, version := range m.Versions {
+		for _, target := range m.Targets {
+			osArch := strings.SplitN(target, "_", 2)
+			if len(osArch) != 2 {
+				return nil, fmt.Errorf("invalid target %q, want os_arch", target)
+			}
+
+			pkg, err := buildOne(m, mainPkg, version, osArch[0], osArch[1])
+			if err != nil {
+				return nil, fmt.Errorf("building %s %s: %w", version, target, err)
+			}
+
+			packages = append(packages, pkg)
+		}
+	}
+
+	return packages, nil
+}
+
+func buildOne(m *Manifest, mainPkg, version, goos, goarch string) (Package, error) {
+	workDir, err := os.MkdirTemp("", "md2jira-bundle-")
+	if err != nil {
+		return Package{}, err
+	}
+	defer os.RemoveAll(workDir)
+
+	binName := fmt.Sprintf("terraform-provider-%s_v%s", m.Type, version)
+	if goos == "windows" {
+		binName += ".exe"
+	}
+	binPath := filepath.Join(workDir, binName)
+
+	cmd := exec.Command("go", "build",
+		"-ldflags", fmt.Sprintf("-X main.version=%s", version),
+		"-o", binPath,
+		mainPkg,
+	)
+	cmd.Env = append(os.Environ(),
+		"GOOS="+goos,
+		"GOARCH="+goarch,
+		"CGO_ENABLED=0",
+	)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return Package{}, fmt.Errorf("go build: %w", err)
+	}
+
+	versionDir := filepath.Join(m.OutputDir, m.Hostname, m.Namespace, m.Type, version)
+	if err := os.MkdirAll(versionDir, 0o755); err != nil {
+		return Package{}, err
+	}
+
+	zipName := fmt.Sprintf("terraform-provider-%s_v%s_%s_%s.zip", m.Type, version, goos, goarch)
+	zipPath := filepath.Join(versionDir, zipName)
+
+	if err := zipBinary(zipPath, binPath, binName); err != nil {
+		return Package{}, fmt.Errorf("zipping: %w", err)
+	}
+
+	sum, err := sha256File(zipPath)
+	if err != nil {
+		return Package{}, err
+	}
+
+	h1, err := dirhash.HashZip(zipPath, dirhash.Hash1)
+	if err != nil {
+		return Package{}, fmt.Errorf("hashing %s: %w", zipPath, err)
+	}
+
+	return Package{
+		Version:  version,
+		OS:       goos,
+		Arch:     goarch,
+		ZipPath:  zipPath,
+		SHA256:   sum,
+		DirHash:  h1,
+		Filename: zipName,
+	}, nil
+}
+
+func zipBinary(zipPath, binPath, binName string) error {
+	out, err := os.Create(zipPath)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	zw := zip.NewWriter(out)
+	defer zw.Close()
+
+	w, err := zw.Create(binName)
+	if err != nil {
+		return err
+	}
+
+	in, err := os.Open(binPath)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	_, err = io.Copy(w, in)
+	return err
+}
+
+func sha256File(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+// Package bundle implements the offline provider bundling used by
+// cmd/md2jira-bundle to produce a `terraform providers mirror`-compatible
+// directory tree for air-gapped Jira deployments.
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Manifest describes what cmd/md2jira-bundle should build, in the spirit of
+// terraform-bundle's package manifest: a set of versions, each cross
+// compiled for a matrix of target OS/arches, written out to OutputDir.
+type Manifest struct {
+	// Hostname and Namespace together with Type form the provider source
+	// address, e.g. "registry.terraform.io/md2jira/jira".
+	Hostname  string `json:"hostname"`
+	Namespace string `json:"namespace"`
+	Type      string `json:"type"`
+
+	// Versions to build. Each entry produces one set of packages.
+	Versions []string `json:"versions"`
+
+	// Targets is the os_arch matrix to cross compile for, e.g.
+	// "linux_amd64", "darwin_arm64", "windows_amd64".
+	Targets []string `json:"targets"`
+
+	// OutputDir is the root of the mirror directory tree that gets written.
+	OutputDir string `json:"output_dir"`
+
+	// Sign, when true, additionally emits a detached GPG signature
+	// (SHA256SUMS.sig) alongside SHA256SUMS using the default local GPG
+	// signing key.
+	Sign bool `json:"sign,omitempty"`
+}
+
+// Address returns the provider source address this manifest builds for,
+// e.g. "registry.terraform.io/md2jira/jira".
+func (m *Manifest) Address() string {
+	return fmt.Sprintf("%s/%s/%s", m.Hostname, m.Namespace, m.Type)
+}
+
+// LoadManifest reads and validates a bundle manifest from path.
+func LoadManifest(path string) (*Manifest, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading manifest: %w", err)
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, fmt.Errorf("parsing manifest %s: %w", path, err)
+	}
+
+	if m.Hostname == "" {
+		m.Hostname = "registry.terraform.io"
+	}
+
+	if m.Namespace == "" || m.Type == "" {
+		return nil, fmt.Errorf("manifest %s: namespace and type are required", path)
+	}
+
+	if len(m.Versions) == 0 {
+		return nil, fmt.Errorf("manifest %s: at least one version is required", path)
+	}
+
+	if len(m.Targets) == 0 {
+		return nil, fmt.Errorf("manifest %s: at least one target is required", path)
+	}
+
+	if m.OutputDir == "" {
+		m.OutputDir = "dist/mirror"
+	}
+
+	return &m, nil
+}
@@ -0,0 +1,173 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+)
+
+// WriteSHA256SUMS writes a terraform-provider-<type>_v<version>_SHA256SUMS
+// file for every version present in packages, and optionally a detached GPG
+// signature alongside it when m.Sign is set.
+func WriteSHA256SUMS(m *Manifest, packages []Package) error {
+	byVersion := map[string][]Package{}
+	for _, pkg := range packages {
+		byVersion[pkg.Version] = append(byVersion[pkg.Version], pkg)
+	}
+
+	for version, pkgs := range byVersion {
+		sort.Slice(pkgs, func(i, j int) bool { return pkgs[i].Filename < pkgs[j].Filename })
+
+		versionDir := filepath.Join(m.OutputDir, m.Hostname, m.Namespace, m.Type, version)
+		sumsPath := filepath.Join(versionDir, fmt.Sprintf("terraform-provider-%s_v%s_SHA256SUMS", m.Type, version))
+
+		f, err := os.Create(sumsPath)
+		if err != nil {
+			return err
+		}
+
+		for _, pkg := range pkgs {
+			if _, err := fmt.Fprintf(f, "%s  %s\n", pkg.SHA256, pkg.Filename); err != nil {
+				f.Close()
+				return err
+			}
+		}
+		f.Close()
+
+		if m.Sign {
+			if err := signFile(sumsPath); err != nil {
+				return fmt.Errorf("signing %s: %w", sumsPath, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// signFile produces a detached GPG signature (<path>.sig) using the
+// caller's default local signing key, mirroring the artifact signing done
+// for public registry releases.
+func signFile(path string) error {
+	cmd := exec.Command("gpg", "--batch", "--yes", "--detach-sign", path)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// mirrorIndex is the body of the network mirror protocol's
+// /<namespace>/<type>/index.json response.
+type mirrorIndex struct {
+	Versions map[string]struct{} `json:"versions"`
+}
+
+// mirrorVersion is the body of the network mirror protocol's
+// /<namespace>/<type>/<version>.json response.
+type mirrorVersion struct {
+	Archives map[string]mirrorArchive `json:"archives"`
+}
+
+type mirrorArchive struct {
+	URL    string   `json:"url"`
+	Hashes []string `json:"hashes"`
+}
+
+// providerManifest mirrors the terraform-registry-manifest.json schema
+// published alongside each GitHub release, declaring which protocol
+// versions this build of the provider speaks.
+type providerManifest struct {
+	Version  int                `json:"version"`
+	Metadata providerManifestMD `json:"metadata"`
+}
+
+type providerManifestMD struct {
+	ProtocolVersions []string `json:"protocol_versions"`
+}
+
+// WriteProviderManifests writes one
+// terraform-provider-<type>_v<version>_manifest.json per version, declaring
+// that the mux'd binary speaks protocol 6 (see main.go's tf6server.Serve).
+func WriteProviderManifests(m *Manifest) error {
+	manifest := providerManifest{
+		Version: 1,
+		Metadata: providerManifestMD{
+			ProtocolVersions: []string{"6.0"},
+		},
+	}
+
+	raw, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	for _, version := range m.Versions {
+		versionDir := filepath.Join(m.OutputDir, m.Hostname, m.Namespace, m.Type, version)
+		if err := os.MkdirAll(versionDir, 0o755); err != nil {
+			return err
+		}
+
+		name := fmt.Sprintf("terraform-provider-%s_v%s_manifest.json", m.Type, version)
+		if err := os.WriteFile(filepath.Join(versionDir, name), raw, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteMirrorIndex writes the index.json and per-version X.Y.Z.json files
+// required by the Terraform network mirror protocol, so that `terraform
+// providers mirror`-consuming Jira installs behind a firewall can point
+// network_mirror at this directory tree over a local file server.
+func WriteMirrorIndex(m *Manifest, packages []Package) error {
+	index := mirrorIndex{Versions: map[string]struct{}{}}
+	byVersion := map[string][]Package{}
+
+	for _, pkg := range packages {
+		index.Versions[pkg.Version] = struct{}{}
+		byVersion[pkg.Version] = append(byVersion[pkg.Version], pkg)
+	}
+
+	providerDir := filepath.Join(m.OutputDir, m.Hostname, m.Namespace, m.Type)
+	if err := os.MkdirAll(providerDir, 0o755); err != nil {
+		return err
+	}
+
+	indexRaw, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(providerDir, "index.json"), indexRaw, 0o644); err != nil {
+		return err
+	}
+
+	for version, pkgs := range byVersion {
+		mv := mirrorVersion{Archives: map[string]mirrorArchive{}}
+
+		for _, pkg := range pkgs {
+			osArch := pkg.OS + "_" + pkg.Arch
+			mv.Archives[osArch] = mirrorArchive{
+				URL: fmt.Sprintf("./%s/%s", version, pkg.Filename),
+				// pkg.DirHash is already "h1:"-prefixed by dirhash.HashZip;
+				// don't glue "h1:" onto the unrelated SHA256SUMS digest.
+				Hashes: []string{pkg.DirHash},
+			}
+		}
+
+		raw, err := json.MarshalIndent(mv, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filepath.Join(providerDir, version+".json"), raw, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package bundle
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/mod/sumdb/dirhash"
+)
+
+func TestWriteMirrorIndexUsesRealDirHash(t *testing.T) {
+	dir := t.TempDir()
+
+	zipPath := filepath.Join(dir, "terraform-provider-jira_v1.0.0_linux_amd64.zip")
+	if err := zipBinary(zipPath, writeTempBinary(t, dir), "terraform-provider-jira_v1.0.0"); err != nil {
+		t.Fatalf("zipBinary() error = %v", err)
+	}
+
+	wantHash, err := dirhash.HashZip(zipPath, dirhash.Hash1)
+	if err != nil {
+		t.Fatalf("dirhash.HashZip() error = %v", err)
+	}
+
+	m := &Manifest{
+		Hostname:  "registry.terraform.io",
+		Namespace: "md2jira",
+		Type:      "jira",
+		OutputDir: dir,
+	}
+
+	pkg := Package{
+		Version:  "1.0.0",
+		OS:       "linux",
+		Arch:     "amd64",
+		ZipPath:  zipPath,
+		SHA256:   "deadbeef",
+		DirHash:  wantHash,
+		Filename: filepath.Base(zipPath),
+	}
+
+	if err := WriteMirrorIndex(m, []Package{pkg}); err != nil {
+		t.Fatalf("WriteMirrorIndex() error = %v", err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(dir, "registry.terraform.io", "md2jira", "jira", "1.0.0.json"))
+	if err != nil {
+		t.Fatalf("reading 1.0.0.json: %v", err)
+	}
+
+	var mv mirrorVersion
+	if err := json.Unmarshal(raw, &mv); err != nil {
+		t.Fatalf("unmarshaling 1.0.0.json: %v", err)
+	}
+
+	archive, ok := mv.Archives["linux_amd64"]
+	if !ok {
+		t.Fatalf("archives = %#v, want a linux_amd64 entry", mv.Archives)
+	}
+
+	if len(archive.Hashes) != 1 || archive.Hashes[0] != wantHash {
+		t.Errorf("Hashes = %v, want [%s]", archive.Hashes, wantHash)
+	}
+
+	if !strings.HasPrefix(archive.Hashes[0], "h1:") {
+		t.Errorf("Hashes[0] = %q, want h1: prefix", archive.Hashes[0])
+	}
+
+	// A real dirhash must never equal "h1:" glued onto a whole-file SHA256 -
+	// that was the bug this test guards against.
+	if archive.Hashes[0] == "h1:"+pkg.SHA256 {
+		t.Errorf("Hashes[0] = %q, looks like the bogus h1:+SHA256 format", archive.Hashes[0])
+	}
+}
+
+func writeTempBinary(t *testing.T, dir string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, "fake-binary")
+	if err := os.WriteFile(path, []byte("not a real provider binary, just test fixture bytes"), 0o755); err != nil {
+		t.Fatalf("writing fixture binary: %v", err)
+	}
+	return path
+}
@@ -0,0 +1,63 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+)
+
+// UploadAttachment uploads the file at localPath as an attachment on the
+// given issue, as required by the Jira REST attachments endpoint
+// (`X-Atlassian-Token: no-check`, multipart/form-data).
+func (c *Client) UploadAttachment(ctx context.Context, issueKey, localPath string, content io.Reader) error {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+
+	part, err := mw.CreateFormFile("file", filepath.Base(localPath))
+	if err != nil {
+		return fmt.Errorf("building attachment upload: %w", err)
+	}
+
+	if _, err := io.Copy(part, content); err != nil {
+		return fmt.Errorf("reading %s: %w", localPath, err)
+	}
+
+	if err := mw.Close(); err != nil {
+		return fmt.Errorf("building attachment upload: %w", err)
+	}
+
+	req, err := c.newRequest(http.MethodPost, fmt.Sprintf("/rest/api/3/issue/%s/attachments", issueKey), buf.Bytes())
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.Header.Set("X-Atlassian-Token", "no-check")
+
+	if c.auth != nil {
+		header, err := c.auth.AuthHeader(ctx)
+		if err != nil {
+			return fmt.Errorf("resolving auth header: %w", err)
+		}
+		req.Header.Set("Authorization", header)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("uploading attachment %s to %s: %w", localPath, issueKey, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("jira API returned %s uploading attachment %s to %s", resp.Status, localPath, issueKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+// Package client implements the internal Jira REST client shared by every
+// resource and datasource in the provider.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/md2jira/terraform-provider-jira/internal/auth"
+)
+
+const defaultUserAgent = "terraform-provider-jira"
+
+// Client is the internal HTTP client used to talk to the Jira REST API.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	userAgent  string
+	auth       auth.Source
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithUserAgent overrides the default User-Agent sent on every request. The
+// provider builds this from the provider version, Terraform Core version,
+// terraform-plugin-framework version and Go runtime so that Jira server
+// access logs can be correlated back to an exact build.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithAuth sets the credential source used to authenticate every request.
+// On a 401 response, do() calls source.Refresh exactly once and retries
+// the request, regardless of which auth method backs it.
+func WithAuth(source auth.Source) Option {
+	return func(c *Client) {
+		c.auth = source
+	}
+}
+
+// WithHTTPClient overrides the http.Client used to issue requests, e.g. in
+// tests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// New returns a Jira REST client for the given base URL.
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		httpClient: http.DefaultClient,
+		baseURL:    baseURL,
+		userAgent:  defaultUserAgent,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// newRequest builds an *http.Request against the Jira API, stamping the
+// configured User-Agent on every outbound call.
+func (c *Client) newRequest(method, path string, body []byte) (*http.Request, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Accept", "application/json")
+
+	return req, nil
+}
+
+// do issues a JSON request against the Jira REST API and decodes the
+// response body into out, if non-nil. A 401 response triggers exactly one
+// credential refresh-and-retry via c.auth, regardless of which resource or
+// datasource goroutine hit it first.
+func (c *Client) do(ctx context.Context, method, path string, body []byte, out any) error {
+	resp, err := c.doOnce(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && c.auth != nil {
+		resp.Body.Close()
+
+		if err := c.auth.Refresh(ctx); err != nil {
+			return fmt.Errorf("refreshing credentials after 401: %w", err)
+		}
+
+		resp, err = c.doOnce(ctx, method, path, body)
+		if err != nil {
+			return err
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("jira API returned %s for %s %s", resp.Status, method, path)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func (c *Client) doOnce(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	req, err := c.newRequest(method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.auth != nil {
+		header, err := c.auth.AuthHeader(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("resolving auth header: %w", err)
+		}
+		req.Header.Set("Authorization", header)
+	}
+
+	return c.httpClient.Do(req)
+}
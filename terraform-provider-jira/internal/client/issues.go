@@ -0,0 +1,159 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Issue is the subset of the Jira REST `/rest/api/3/issue` response that
+// md2jira cares about.
+type Issue struct {
+	Key    string      `json:"key"`
+	Fields IssueFields `json:"fields"`
+}
+
+// IssueFields is the Jira REST issue "fields" object.
+type IssueFields struct {
+	Summary     string         `json:"summary"`
+	Description map[string]any `json:"description"`
+	Labels      []string       `json:"labels,omitempty"`
+	Project     IssueRef       `json:"project"`
+	IssueType   IssueRef       `json:"issuetype"`
+	Assignee    *IssueRef      `json:"assignee,omitempty"`
+	EpicLink    string         `json:"customfield_10014,omitempty"`
+
+	// CustomFields holds arbitrary `customfield_NNNNN` keys from the
+	// document's front matter. Jira expects these merged into the
+	// top-level fields object rather than nested, so they're excluded
+	// here (json:"-") and folded in by MarshalJSON instead.
+	CustomFields map[string]string `json:"-"`
+
+	// Subtasks and Attachments are only ever populated by GetIssue; they
+	// let callers resync a markdown document's subtasks/attachments
+	// against what Jira already has instead of re-creating them blindly.
+	Subtasks    []SubtaskRef    `json:"subtasks,omitempty"`
+	Attachments []AttachmentRef `json:"attachment,omitempty"`
+}
+
+// SubtaskRef is one entry of an issue's "subtasks" field, as returned by
+// GetIssue.
+type SubtaskRef struct {
+	Key    string `json:"key"`
+	Fields struct {
+		Summary string `json:"summary"`
+	} `json:"fields"`
+}
+
+// AttachmentRef is one entry of an issue's "attachment" field, as returned
+// by GetIssue.
+type AttachmentRef struct {
+	Filename string `json:"filename"`
+}
+
+// MarshalJSON merges CustomFields into the top-level fields object, since
+// Jira has no nested slot for custom fields - each customfield_NNNNN key
+// sits alongside summary, labels, etc.
+func (f IssueFields) MarshalJSON() ([]byte, error) {
+	type alias IssueFields
+
+	raw, err := json.Marshal(alias(f))
+	if err != nil {
+		return nil, err
+	}
+
+	var out map[string]any
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+
+	for k, v := range f.CustomFields {
+		out[k] = v
+	}
+
+	return json.Marshal(out)
+}
+
+// IssueRef is a named/keyed reference to a Jira entity (project, issue
+// type, user) as embedded in issue fields.
+type IssueRef struct {
+	ID        string `json:"id,omitempty"`
+	Key       string `json:"key,omitempty"`
+	Name      string `json:"name,omitempty"`
+	AccountID string `json:"accountId,omitempty"`
+}
+
+// CreateIssue creates a Jira issue from fields and returns the created
+// issue key.
+func (c *Client) CreateIssue(ctx context.Context, fields IssueFields) (string, error) {
+	body, err := json.Marshal(map[string]any{"fields": fields})
+	if err != nil {
+		return "", fmt.Errorf("encoding issue: %w", err)
+	}
+
+	var created Issue
+	if err := c.do(ctx, http.MethodPost, "/rest/api/3/issue", body, &created); err != nil {
+		return "", fmt.Errorf("creating issue: %w", err)
+	}
+
+	return created.Key, nil
+}
+
+// GetIssue fetches an issue by key, with its description normalized to ADF
+// by the Jira REST API. Callers diff this against the locally rendered
+// ADF to detect drift without cosmetic whitespace churning the plan.
+func (c *Client) GetIssue(ctx context.Context, key string) (Issue, error) {
+	var issue Issue
+	if err := c.do(ctx, http.MethodGet, "/rest/api/3/issue/"+key, nil, &issue); err != nil {
+		return Issue{}, fmt.Errorf("getting issue %s: %w", key, err)
+	}
+	return issue, nil
+}
+
+// UpdateIssue updates the given fields on an existing issue.
+func (c *Client) UpdateIssue(ctx context.Context, key string, fields IssueFields) error {
+	body, err := json.Marshal(map[string]any{"fields": fields})
+	if err != nil {
+		return fmt.Errorf("encoding issue: %w", err)
+	}
+
+	if err := c.do(ctx, http.MethodPut, "/rest/api/3/issue/"+key, body, nil); err != nil {
+		return fmt.Errorf("updating issue %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// DeleteIssue deletes an issue by key.
+func (c *Client) DeleteIssue(ctx context.Context, key string) error {
+	if err := c.do(ctx, http.MethodDelete, "/rest/api/3/issue/"+key, nil, nil); err != nil {
+		return fmt.Errorf("deleting issue %s: %w", key, err)
+	}
+	return nil
+}
+
+// CreateSubtask creates a subtask issue linked to parentKey.
+func (c *Client) CreateSubtask(ctx context.Context, parentKey string, fields IssueFields) (string, error) {
+	body, err := json.Marshal(map[string]any{
+		"fields": map[string]any{
+			"summary":   fields.Summary,
+			"project":   fields.Project,
+			"issuetype": map[string]string{"name": "Sub-task"},
+			"parent":    map[string]string{"key": parentKey},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("encoding subtask: %w", err)
+	}
+
+	var created Issue
+	if err := c.do(ctx, http.MethodPost, "/rest/api/3/issue", body, &created); err != nil {
+		return "", fmt.Errorf("creating subtask under %s: %w", parentKey, err)
+	}
+
+	return created.Key, nil
+}
@@ -0,0 +1,272 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package markdown
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// parser has the GFM extensions (tables, task lists) enabled; the
+// goldmark default parser doesn't register them, so without this tables
+// and `- [ ]` items would just come back as plain paragraphs/lists.
+var parser = goldmark.New(goldmark.WithExtensions(extension.GFM))
+
+// ADFNode is a single node in an Atlassian Document Format tree. It is
+// intentionally untyped (map[string]any) rather than a generated struct so
+// that new ADF node kinds don't require touching this package.
+type ADFNode = map[string]any
+
+// Subtask is a task-list item pulled out of the document body. md2jira
+// creates these as real Jira subtasks rather than leaving them as markdown
+// checkboxes, since Jira has no native "checklist" block in ADF.
+type Subtask struct {
+	Summary string
+	Done    bool
+}
+
+// Attachment is an image reference pulled out of the document body.
+// md2jira uploads the referenced file via the Jira REST attachments
+// endpoint. The rendered ADF has no media node for it; only the image's
+// alt text appears, as plain text in the surrounding paragraph.
+type Attachment struct {
+	// Path is relative to the source markdown file's directory.
+	Path string
+	Alt  string
+}
+
+// Rendered is the result of converting a document body to ADF: the
+// document itself, plus the subtasks and attachments extracted along the
+// way so the resource can create/upload them as separate Jira API calls.
+type Rendered struct {
+	Doc         ADFNode
+	Subtasks    []Subtask
+	Attachments []Attachment
+}
+
+// Render converts a document body (markdown, front matter already
+// stripped) into Jira ADF. Headings become panel nodes, fenced code
+// becomes codeBlock nodes, tables become table nodes, task list items are
+// extracted as Subtasks, and image references are extracted as
+// Attachments.
+func Render(body string) (Rendered, error) {
+	source := []byte(body)
+	doc := parser.Parser().Parse(text.NewReader(source))
+
+	r := Rendered{Doc: ADFNode{
+		"type":    "doc",
+		"version": 1,
+	}}
+
+	var content []ADFNode
+	err := ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering || n.Parent() != doc {
+			return ast.WalkContinue, nil
+		}
+
+		switch v := n.(type) {
+		case *ast.Heading:
+			content = append(content, headingToPanel(v, source))
+		case *ast.FencedCodeBlock:
+			content = append(content, codeBlockNode(v, source))
+		case *east.Table:
+			content = append(content, tableNode(v, source))
+		case *ast.List:
+			if isTaskList(v) {
+				r.Subtasks = append(r.Subtasks, extractSubtasks(v, source)...)
+			} else {
+				content = append(content, listNode(v, source))
+			}
+		case *ast.Paragraph:
+			content = append(content, paragraphNode(v, source))
+		}
+
+		return ast.WalkSkipChildren, nil
+	})
+	if err != nil {
+		return Rendered{}, err
+	}
+
+	r.Attachments = extractAttachments(doc, source)
+	r.Doc["content"] = content
+
+	return r, nil
+}
+
+func headingToPanel(h *ast.Heading, source []byte) ADFNode {
+	panelType := "info"
+	if h.Level == 1 {
+		panelType = "note"
+	}
+
+	return ADFNode{
+		"type": "panel",
+		"attrs": ADFNode{
+			"panelType": panelType,
+		},
+		"content": []ADFNode{paragraphText(string(h.Text(source)))},
+	}
+}
+
+func codeBlockNode(c *ast.FencedCodeBlock, source []byte) ADFNode {
+	var buf bytes.Buffer
+	for i := 0; i < c.Lines().Len(); i++ {
+		line := c.Lines().At(i)
+		buf.Write(line.Value(source))
+	}
+
+	attrs := ADFNode{}
+	if lang := c.Language(source); lang != nil {
+		attrs["language"] = string(lang)
+	}
+
+	return ADFNode{
+		"type":    "codeBlock",
+		"attrs":   attrs,
+		"content": []ADFNode{{"type": "text", "text": buf.String()}},
+	}
+}
+
+func tableNode(t *east.Table, source []byte) ADFNode {
+	var rows []ADFNode
+
+	for child := t.FirstChild(); child != nil; child = child.NextSibling() {
+		row, ok := child.(*east.TableRow)
+		if !ok {
+			if headerRow, ok := child.(*east.TableHeader); ok {
+				rows = append(rows, tableRowNode(headerRow, source, true))
+			}
+			continue
+		}
+		rows = append(rows, tableRowNode(row, source, false))
+	}
+
+	return ADFNode{
+		"type":    "table",
+		"content": rows,
+	}
+}
+
+func tableRowNode(row ast.Node, source []byte, header bool) ADFNode {
+	cellType := "tableCell"
+	if header {
+		cellType = "tableHeader"
+	}
+
+	var cells []ADFNode
+	for cell := row.FirstChild(); cell != nil; cell = cell.NextSibling() {
+		cells = append(cells, ADFNode{
+			"type":    cellType,
+			"content": []ADFNode{paragraphText(string(cell.Text(source)))},
+		})
+	}
+
+	return ADFNode{"type": "tableRow", "content": cells}
+}
+
+func paragraphNode(p *ast.Paragraph, source []byte) ADFNode {
+	return paragraphText(string(p.Text(source)))
+}
+
+func paragraphText(s string) ADFNode {
+	return ADFNode{
+		"type":    "paragraph",
+		"content": []ADFNode{{"type": "text", "text": s}},
+	}
+}
+
+func listNode(l *ast.List, source []byte) ADFNode {
+	listType := "bulletList"
+	if l.IsOrdered() {
+		listType = "orderedList"
+	}
+
+	var items []ADFNode
+	for item := l.FirstChild(); item != nil; item = item.NextSibling() {
+		items = append(items, ADFNode{
+			"type":    "listItem",
+			"content": []ADFNode{paragraphText(string(item.Text(source)))},
+		})
+	}
+
+	return ADFNode{"type": listType, "content": items}
+}
+
+// taskCheckBox returns the task-list checkbox marker for a list item, or
+// nil if the item isn't part of a task list - including a blank "-" item
+// with no inline content at all, which has no block to look inside.
+func taskCheckBox(item ast.Node) *east.TaskCheckBox {
+	block := item.FirstChild()
+	if block == nil {
+		return nil
+	}
+	box, _ := block.FirstChild().(*east.TaskCheckBox)
+	return box
+}
+
+func isTaskList(l *ast.List) bool {
+	for item := l.FirstChild(); item != nil; item = item.NextSibling() {
+		if taskCheckBox(item) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// extractSubtasks converts a task list's items into Subtasks. Each item's
+// summary is the text of the inline nodes after the checkbox marker, with
+// surrounding whitespace trimmed, so "- [ ] Write docs" yields "Write docs".
+func extractSubtasks(l *ast.List, source []byte) []Subtask {
+	var subtasks []Subtask
+
+	for item := l.FirstChild(); item != nil; item = item.NextSibling() {
+		box := taskCheckBox(item)
+
+		var summary string
+		if block := item.FirstChild(); block != nil {
+			var buf bytes.Buffer
+			for child := block.FirstChild(); child != nil; child = child.NextSibling() {
+				if _, ok := child.(*east.TaskCheckBox); ok {
+					continue
+				}
+				buf.Write(child.Text(source))
+			}
+			summary = strings.TrimSpace(buf.String())
+		}
+
+		subtasks = append(subtasks, Subtask{
+			Summary: summary,
+			Done:    box != nil && box.IsChecked,
+		})
+	}
+
+	return subtasks
+}
+
+func extractAttachments(doc ast.Node, source []byte) []Attachment {
+	var attachments []Attachment
+
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		if img, ok := n.(*ast.Image); ok {
+			attachments = append(attachments, Attachment{
+				Path: string(img.Destination),
+				Alt:  string(img.Text(source)),
+			})
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return attachments
+}
@@ -0,0 +1,135 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package markdown
+
+import "testing"
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name  string
+		body  string
+		check func(t *testing.T, r Rendered)
+	}{
+		{
+			name: "headings become panels by level",
+			body: "# Title\n\n## Notes\n\n```go\nfmt.Println(\"hi\")\n```\n",
+			check: func(t *testing.T, r Rendered) {
+				content, ok := r.Doc["content"].([]ADFNode)
+				if !ok || len(content) != 3 {
+					t.Fatalf("content = %#v, want 3 top-level nodes", r.Doc["content"])
+				}
+				if content[0]["type"] != "panel" || content[0]["attrs"].(ADFNode)["panelType"] != "note" {
+					t.Errorf("h1 = %#v, want note panel", content[0])
+				}
+				if content[1]["type"] != "panel" || content[1]["attrs"].(ADFNode)["panelType"] != "info" {
+					t.Errorf("h2 = %#v, want info panel", content[1])
+				}
+				if content[2]["type"] != "codeBlock" {
+					t.Errorf("code block = %#v, want codeBlock", content[2])
+				}
+			},
+		},
+		{
+			name: "pipe table becomes a table node",
+			body: "| A | B |\n| --- | --- |\n| 1 | 2 |\n",
+			check: func(t *testing.T, r Rendered) {
+				content := r.Doc["content"].([]ADFNode)
+				if len(content) != 1 || content[0]["type"] != "table" {
+					t.Fatalf("content = %#v, want a single table node", content)
+				}
+
+				rows := content[0]["content"].([]ADFNode)
+				if len(rows) != 2 {
+					t.Fatalf("rows = %#v, want header + 1 data row", rows)
+				}
+
+				headerCells := rows[0]["content"].([]ADFNode)
+				if headerCells[0]["type"] != "tableHeader" {
+					t.Errorf("header cell type = %v, want tableHeader", headerCells[0]["type"])
+				}
+
+				dataCells := rows[1]["content"].([]ADFNode)
+				if dataCells[0]["type"] != "tableCell" {
+					t.Errorf("data cell type = %v, want tableCell", dataCells[0]["type"])
+				}
+			},
+		},
+		{
+			name: "task list items become subtasks with the checkbox marker stripped",
+			body: "- [ ] Write docs\n- [x] Ship it\n",
+			check: func(t *testing.T, r Rendered) {
+				want := []Subtask{
+					{Summary: "Write docs", Done: false},
+					{Summary: "Ship it", Done: true},
+				}
+				if len(r.Subtasks) != len(want) {
+					t.Fatalf("Subtasks = %#v, want %#v", r.Subtasks, want)
+				}
+				for i, s := range want {
+					if r.Subtasks[i] != s {
+						t.Errorf("Subtasks[%d] = %#v, want %#v", i, r.Subtasks[i], s)
+					}
+				}
+
+				if content, ok := r.Doc["content"].([]ADFNode); ok && len(content) != 0 {
+					t.Errorf("content = %#v, want task list consumed entirely as subtasks", content)
+				}
+			},
+		},
+		{
+			name: "blank task list item does not panic and gets an empty summary",
+			body: "- [ ] a\n-\n",
+			check: func(t *testing.T, r Rendered) {
+				want := []Subtask{
+					{Summary: "a", Done: false},
+					{Summary: "", Done: false},
+				}
+				if len(r.Subtasks) != len(want) {
+					t.Fatalf("Subtasks = %#v, want %#v", r.Subtasks, want)
+				}
+				for i, s := range want {
+					if r.Subtasks[i] != s {
+						t.Errorf("Subtasks[%d] = %#v, want %#v", i, r.Subtasks[i], s)
+					}
+				}
+			},
+		},
+		{
+			name: "plain bullet list is not treated as subtasks",
+			body: "- Milk\n- Eggs\n",
+			check: func(t *testing.T, r Rendered) {
+				if len(r.Subtasks) != 0 {
+					t.Fatalf("Subtasks = %#v, want none for a plain bullet list", r.Subtasks)
+				}
+
+				content := r.Doc["content"].([]ADFNode)
+				if len(content) != 1 || content[0]["type"] != "bulletList" {
+					t.Fatalf("content = %#v, want a single bulletList node", content)
+				}
+			},
+		},
+		{
+			name: "images are extracted as attachments",
+			body: "# Title\n\n![a diagram](diagram.png)\n",
+			check: func(t *testing.T, r Rendered) {
+				if len(r.Attachments) != 1 {
+					t.Fatalf("Attachments = %#v, want 1", r.Attachments)
+				}
+				if r.Attachments[0].Path != "diagram.png" || r.Attachments[0].Alt != "a diagram" {
+					t.Errorf("Attachments[0] = %#v, want {diagram.png, a diagram}", r.Attachments[0])
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, err := Render(tt.body)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			tt.check(t, rendered)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package markdown
+
+import (
+	"reflect"
+	"strings"
+)
+
+// EqualADF reports whether two ADF documents are structurally identical,
+// ignoring the kind of cosmetic whitespace differences Jira's own ADF
+// normalizer introduces (e.g. trailing newlines in text nodes). It is used
+// to decide whether re-rendering a markdown document actually changed the
+// issue, so that plans don't churn on whitespace alone.
+func EqualADF(a, b ADFNode) bool {
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+func normalize(n any) any {
+	switch v := n.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, val := range v {
+			if k == "text" {
+				if s, ok := val.(string); ok {
+					out[k] = trimWhitespace(s)
+					continue
+				}
+			}
+			out[k] = normalize(val)
+		}
+		return out
+	case []ADFNode:
+		out := make([]any, len(v))
+		for i, val := range v {
+			out[i] = normalize(val)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, val := range v {
+			out[i] = normalize(val)
+		}
+		return out
+	case int:
+		// Locally rendered numbers are ints; JSON-decoded ones from Jira
+		// are float64.
+		return float64(v)
+	default:
+		return v
+	}
+}
+
+func trimWhitespace(s string) string {
+	out := make([]rune, 0, len(s))
+	prevSpace := false
+	for _, r := range s {
+		isSpace := r == ' ' || r == '\n' || r == '\t' || r == '\r'
+		if isSpace && prevSpace {
+			continue
+		}
+		if isSpace {
+			out = append(out, ' ')
+		} else {
+			out = append(out, r)
+		}
+		prevSpace = isSpace
+	}
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,54 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package markdown
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// WalkDirectory parses every *.md file under root into an IssueSpec,
+// suitable for `for_each` over the jira_markdown_document datasource's
+// `issues` attribute. Files are returned sorted by path for a stable plan.
+func WalkDirectory(root string) ([]IssueSpec, error) {
+	var paths []string
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.ToLower(filepath.Ext(path)) != ".md" {
+			return nil
+		}
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walking %s: %w", root, err)
+	}
+
+	sort.Strings(paths)
+
+	specs := make([]IssueSpec, 0, len(paths))
+	for _, path := range paths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+
+		spec, err := ParseDocument(raw)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+
+		spec.SourcePath = path
+		specs = append(specs, spec)
+	}
+
+	return specs, nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const frontMatterDelim = "---"
+
+// frontMatter is the YAML shape expected at the top of a md2jira document.
+type frontMatter struct {
+	Project      string            `yaml:"project"`
+	IssueType    string            `yaml:"issuetype"`
+	Labels       []string          `yaml:"labels"`
+	Assignee     string            `yaml:"assignee"`
+	EpicLink     string            `yaml:"epic_link"`
+	CustomFields map[string]string `yaml:"custom_fields"`
+	Summary      string            `yaml:"summary"`
+}
+
+// ParseDocument splits a markdown document into front matter and body, and
+// returns the resulting IssueSpec. Documents without front matter are
+// accepted; Project and IssueType must then be supplied by the caller
+// (e.g. jira_markdown_issue's own `project`/`issuetype` arguments).
+func ParseDocument(raw []byte) (IssueSpec, error) {
+	fm, body, err := splitFrontMatter(raw)
+	if err != nil {
+		return IssueSpec{}, err
+	}
+
+	spec := IssueSpec{
+		Project:      fm.Project,
+		IssueType:    fm.IssueType,
+		Labels:       fm.Labels,
+		Assignee:     fm.Assignee,
+		EpicLink:     fm.EpicLink,
+		CustomFields: fm.CustomFields,
+		Summary:      fm.Summary,
+		Body:         body,
+	}
+
+	if spec.Summary == "" {
+		spec.Summary = firstHeading(body)
+	}
+
+	return spec, nil
+}
+
+func splitFrontMatter(raw []byte) (frontMatter, string, error) {
+	text := string(raw)
+
+	if !strings.HasPrefix(strings.TrimLeft(text, "\r\n"), frontMatterDelim) {
+		return frontMatter{}, text, nil
+	}
+
+	text = strings.TrimLeft(text, "\r\n")
+	rest := strings.TrimPrefix(text, frontMatterDelim)
+
+	end := strings.Index(rest, "\n"+frontMatterDelim)
+	if end == -1 {
+		return frontMatter{}, "", fmt.Errorf("unterminated front matter: missing closing %q", frontMatterDelim)
+	}
+
+	yamlBlock := rest[:end]
+	body := strings.TrimPrefix(rest[end+len(frontMatterDelim)+1:], "\n")
+
+	var fm frontMatter
+	dec := yaml.NewDecoder(bytes.NewReader([]byte(yamlBlock)))
+	if err := dec.Decode(&fm); err != nil {
+		return frontMatter{}, "", fmt.Errorf("parsing front matter: %w", err)
+	}
+
+	return fm, body, nil
+}
+
+// firstHeading returns the text of the first level-1 markdown heading in
+// body, or "" if there isn't one.
+func firstHeading(body string) string {
+	for _, line := range strings.Split(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "# ") {
+			return strings.TrimSpace(strings.TrimPrefix(trimmed, "# "))
+		}
+	}
+
+	return ""
+}
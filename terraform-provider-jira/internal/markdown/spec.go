@@ -0,0 +1,31 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+// Package markdown implements the md2jira conversion layer: parsing a
+// markdown document with Jira-oriented front matter into an IssueSpec, and
+// rendering its body to Atlassian Document Format (ADF) for the Jira REST
+// API.
+package markdown
+
+// IssueSpec is the Jira-facing representation of a single markdown
+// document, as consumed by the jira_markdown_issue resource and produced
+// by the jira_markdown_document datasource.
+type IssueSpec struct {
+	// SourcePath is the file the spec was parsed from, empty for inline
+	// content.
+	SourcePath string
+
+	Project      string
+	IssueType    string
+	Labels       []string
+	Assignee     string
+	EpicLink     string
+	CustomFields map[string]string
+
+	// Summary is the issue summary, taken from the first level-1 heading
+	// in the body if not set explicitly in front matter.
+	Summary string
+
+	// Body is the markdown content after front matter has been stripped.
+	Body string
+}
@@ -0,0 +1,95 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/md2jira/terraform-provider-jira/internal/markdown"
+)
+
+var _ datasource.DataSource = &MarkdownDocumentDataSource{}
+
+// MarkdownDocumentDataSource is the jira_markdown_document datasource: it
+// parses every markdown file under a directory into an issue spec, for use
+// with `for_each` over jira_markdown_issue.
+type MarkdownDocumentDataSource struct{}
+
+// MarkdownDocumentModel is the Terraform schema for jira_markdown_document.
+type MarkdownDocumentModel struct {
+	Path   types.String           `tfsdk:"path"`
+	Issues []MarkdownIssueSummary `tfsdk:"issues"`
+}
+
+// MarkdownIssueSummary is one entry of jira_markdown_document's `issues`
+// list attribute.
+type MarkdownIssueSummary struct {
+	Path      types.String `tfsdk:"path"`
+	Project   types.String `tfsdk:"project"`
+	IssueType types.String `tfsdk:"issuetype"`
+	Summary   types.String `tfsdk:"summary"`
+}
+
+func NewMarkdownDocumentDataSource() datasource.DataSource {
+	return &MarkdownDocumentDataSource{}
+}
+
+func (d *MarkdownDocumentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_markdown_document"
+}
+
+func (d *MarkdownDocumentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Parses a directory tree of markdown documents into a list of Jira issue specs, suitable for `for_each` over jira_markdown_issue.",
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "Directory to recursively search for *.md files.",
+			},
+			"issues": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "One entry per markdown file found under `path`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path":      schema.StringAttribute{Computed: true},
+						"project":   schema.StringAttribute{Computed: true},
+						"issuetype": schema.StringAttribute{Computed: true},
+						"summary":   schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MarkdownDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MarkdownDocumentModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	specs, err := markdown.WalkDirectory(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Reading Markdown Directory Failed", fmt.Sprintf("%s: %s", data.Path.ValueString(), err))
+		return
+	}
+
+	data.Issues = make([]MarkdownIssueSummary, 0, len(specs))
+	for _, spec := range specs {
+		data.Issues = append(data.Issues, MarkdownIssueSummary{
+			Path:      types.StringValue(spec.SourcePath),
+			Project:   types.StringValue(spec.Project),
+			IssueType: types.StringValue(spec.IssueType),
+			Summary:   types.StringValue(spec.Summary),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,227 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/md2jira/terraform-provider-jira/internal/auth"
+	"github.com/md2jira/terraform-provider-jira/internal/client"
+)
+
+// Ensure JiraProvider satisfies various provider interfaces.
+var _ provider.Provider = &JiraProvider{}
+
+// JiraProvider is the terraform-plugin-framework implementation of the Jira
+// provider. It is combined with the legacy SDKv2 provider in main.go via a
+// protocol mux so that resources can be ported over incrementally.
+type JiraProvider struct {
+	// version is set to the provider version on release, "dev" when the
+	// provider is built and ran locally, and "test" when running acceptance
+	// testing.
+	version string
+
+	// frameworkVersion is the terraform-plugin-framework version this
+	// binary was built against, set via -ldflags in main.go. It is folded
+	// into the client User-Agent alongside the provider and Terraform Core
+	// versions.
+	frameworkVersion string
+}
+
+// JiraProviderModel describes the provider data model.
+type JiraProviderModel struct {
+	Host    types.String `tfsdk:"host"`
+	Profile types.String `tfsdk:"profile"`
+	Auth    *AuthModel   `tfsdk:"auth"`
+}
+
+// AuthModel is the provider's discriminated `auth {}` block: exactly one
+// of Basic, Bearer or OAuth2 should be set. When the block is omitted
+// entirely, Configure falls back to auth.Resolve's credential chain.
+type AuthModel struct {
+	Basic  *BasicAuthModel  `tfsdk:"basic"`
+	Bearer *BearerAuthModel `tfsdk:"bearer"`
+	OAuth2 *OAuth2AuthModel `tfsdk:"oauth2"`
+}
+
+// BasicAuthModel is Jira Cloud email + API token auth.
+type BasicAuthModel struct {
+	Email    types.String `tfsdk:"email"`
+	APIToken types.String `tfsdk:"api_token"`
+}
+
+// BearerAuthModel is a Jira Data Center Personal Access Token.
+type BearerAuthModel struct {
+	Token types.String `tfsdk:"token"`
+}
+
+// OAuth2AuthModel is either the client_credentials grant (no refresh
+// token) or the 3LO grant with a persisted refresh token.
+type OAuth2AuthModel struct {
+	ClientID       types.String `tfsdk:"client_id"`
+	ClientSecret   types.String `tfsdk:"client_secret"`
+	RefreshToken   types.String `tfsdk:"refresh_token"`
+	TokenURL       types.String `tfsdk:"token_url"`
+	TokenCachePath types.String `tfsdk:"token_cache_path"`
+}
+
+func (p *JiraProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "jira"
+	resp.Version = p.version
+}
+
+func (p *JiraProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Optional:    true,
+				Description: "Base URL of the Jira instance, e.g. \"https://example.atlassian.net\".",
+			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "Named profile to read from ~/.config/md2jira/credentials when `auth` is not set and no credential env var is present. Defaults to \"default\".",
+			},
+			"auth": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Explicit credentials. When omitted, credentials are resolved from JIRA_TOKEN/JIRA_OAUTH_REFRESH_TOKEN/ATLASSIAN_API_TOKEN, then the `profile` in ~/.config/md2jira/credentials.",
+				Attributes: map[string]schema.Attribute{
+					"basic": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Jira Cloud email + API token.",
+						Attributes: map[string]schema.Attribute{
+							"email":     schema.StringAttribute{Required: true},
+							"api_token": schema.StringAttribute{Required: true, Sensitive: true},
+						},
+					},
+					"bearer": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Jira Data Center Personal Access Token.",
+						Attributes: map[string]schema.Attribute{
+							"token": schema.StringAttribute{Required: true, Sensitive: true},
+						},
+					},
+					"oauth2": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "OAuth 2.0 client_credentials or 3LO with a refresh token.",
+						Attributes: map[string]schema.Attribute{
+							"client_id":        schema.StringAttribute{Required: true},
+							"client_secret":    schema.StringAttribute{Required: true, Sensitive: true},
+							"refresh_token":    schema.StringAttribute{Optional: true, Sensitive: true},
+							"token_url":        schema.StringAttribute{Required: true},
+							"token_cache_path": schema.StringAttribute{Optional: true, Description: "Path to persist a rotated refresh token to."},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (p *JiraProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data JiraProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source, err := auth.Resolve(data.Auth.credentials(), data.Profile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Resolve Jira Credentials", err.Error())
+		return
+	}
+
+	jiraClient := client.New(
+		data.Host.ValueString(),
+		client.WithUserAgent(p.userAgent(req.TerraformVersion)),
+		client.WithAuth(source),
+	)
+
+	resp.DataSourceData = jiraClient
+	resp.ResourceData = jiraClient
+}
+
+// credentials converts the `auth {}` block, if any, into auth.Credentials.
+// A nil receiver (block omitted) yields a nil result, telling auth.Resolve
+// to fall through to the environment/profile chain.
+func (a *AuthModel) credentials() *auth.Credentials {
+	switch {
+	case a == nil:
+		return nil
+	case a.Basic != nil:
+		return &auth.Credentials{
+			Method:   "basic",
+			Email:    a.Basic.Email.ValueString(),
+			APIToken: a.Basic.APIToken.ValueString(),
+		}
+	case a.Bearer != nil:
+		return &auth.Credentials{
+			Method: "bearer",
+			PAT:    a.Bearer.Token.ValueString(),
+		}
+	case a.OAuth2 != nil:
+		return &auth.Credentials{
+			Method: "oauth2",
+			OAuth2: &auth.OAuth2Config{
+				ClientID:       a.OAuth2.ClientID.ValueString(),
+				ClientSecret:   a.OAuth2.ClientSecret.ValueString(),
+				RefreshToken:   a.OAuth2.RefreshToken.ValueString(),
+				TokenURL:       a.OAuth2.TokenURL.ValueString(),
+				TokenCachePath: a.OAuth2.TokenCachePath.ValueString(),
+			},
+		}
+	default:
+		return nil
+	}
+}
+
+// userAgent builds the string sent as User-Agent on every Jira REST call,
+// e.g. "terraform-provider-jira/1.2.3 (linux/amd64) terraform/1.7.0
+// terraform-plugin-framework/1.5.0 go/1.21.5". tfVersion comes from
+// req.TerraformVersion, which Terraform Core populates on every Configure
+// call.
+func (p *JiraProvider) userAgent(tfVersion string) string {
+	return fmt.Sprintf(
+		"terraform-provider-jira/%s (%s/%s) terraform/%s terraform-plugin-framework/%s go/%s",
+		p.version,
+		runtime.GOOS,
+		runtime.GOARCH,
+		tfVersion,
+		p.frameworkVersion,
+		runtime.Version(),
+	)
+}
+
+func (p *JiraProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewMarkdownIssueResource,
+	}
+}
+
+func (p *JiraProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewMarkdownDocumentDataSource,
+	}
+}
+
+// New returns a provider factory for the terraform-plugin-framework half of
+// the provider. main.go wraps it with providerserver.NewProtocol6 and muxes
+// it alongside the legacy SDKv2 provider.
+func New(version, frameworkVersion string) func() provider.Provider {
+	return func() provider.Provider {
+		return &JiraProvider{
+			version:          version,
+			frameworkVersion: frameworkVersion,
+		}
+	}
+}
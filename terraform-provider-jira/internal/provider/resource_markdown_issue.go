@@ -0,0 +1,411 @@
+// Copyright (c) md2jira
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/md2jira/terraform-provider-jira/internal/client"
+	"github.com/md2jira/terraform-provider-jira/internal/markdown"
+)
+
+var (
+	_ resource.Resource              = &MarkdownIssueResource{}
+	_ resource.ResourceWithConfigure = &MarkdownIssueResource{}
+)
+
+// MarkdownIssueResource is the jira_markdown_issue resource: it converts a
+// single markdown document (inline or from disk) into a Jira issue, and
+// keeps it in sync as the source document changes.
+type MarkdownIssueResource struct {
+	client *client.Client
+}
+
+// MarkdownIssueModel is the Terraform schema for jira_markdown_issue.
+type MarkdownIssueModel struct {
+	Path      types.String `tfsdk:"path"`
+	Content   types.String `tfsdk:"content"`
+	Project   types.String `tfsdk:"project"`
+	IssueType types.String `tfsdk:"issuetype"`
+	Labels    types.List   `tfsdk:"labels"`
+	Assignee  types.String `tfsdk:"assignee"`
+	EpicLink  types.String `tfsdk:"epic_link"`
+
+	IssueKey types.String `tfsdk:"issue_key"`
+	Summary  types.String `tfsdk:"summary"`
+}
+
+func NewMarkdownIssueResource() resource.Resource {
+	return &MarkdownIssueResource{}
+}
+
+func (r *MarkdownIssueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_markdown_issue"
+}
+
+func (r *MarkdownIssueResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Converts a markdown document with Jira front matter into a Jira issue, re-rendering it to ADF on every apply.",
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a markdown file. Mutually exclusive with `content`.",
+			},
+			"content": schema.StringAttribute{
+				Optional:    true,
+				Description: "Inline markdown document. Mutually exclusive with `path`.",
+			},
+			"project": schema.StringAttribute{
+				Optional:    true,
+				Description: "Jira project key. Overrides the `project` front matter field.",
+			},
+			"issuetype": schema.StringAttribute{
+				Optional:    true,
+				Description: "Jira issue type name. Overrides the `issuetype` front matter field.",
+			},
+			"labels": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Labels to apply, merged with any `labels` set in front matter.",
+			},
+			"assignee": schema.StringAttribute{
+				Optional:    true,
+				Description: "Account ID to assign the issue to.",
+			},
+			"epic_link": schema.StringAttribute{
+				Optional:    true,
+				Description: "Key of the epic to link this issue to.",
+			},
+			"issue_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Key of the created Jira issue, e.g. \"PROJ-123\".",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"summary": schema.StringAttribute{
+				Computed:    true,
+				Description: "Issue summary, taken from front matter or the document's first heading.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MarkdownIssueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("expected *client.Client, got %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
+
+func (r *MarkdownIssueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MarkdownIssueModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spec, baseDir, diags := r.loadSpec(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rendered, err := markdown.Render(spec.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Markdown Conversion Failed", err.Error())
+		return
+	}
+
+	fields := client.IssueFields{
+		Summary:      spec.Summary,
+		Description:  rendered.Doc,
+		Labels:       spec.Labels,
+		Project:      client.IssueRef{Key: spec.Project},
+		IssueType:    client.IssueRef{Name: spec.IssueType},
+		EpicLink:     spec.EpicLink,
+		CustomFields: spec.CustomFields,
+	}
+	if spec.Assignee != "" {
+		fields.Assignee = &client.IssueRef{AccountID: spec.Assignee}
+	}
+
+	issueKey, err := r.client.CreateIssue(ctx, fields)
+	if err != nil {
+		resp.Diagnostics.AddError("Creating Jira Issue Failed", err.Error())
+		return
+	}
+
+	for _, subtask := range rendered.Subtasks {
+		if _, err := r.client.CreateSubtask(ctx, issueKey, client.IssueFields{
+			Summary: subtask.Summary,
+			Project: fields.Project,
+		}); err != nil {
+			resp.Diagnostics.AddError("Creating Subtask Failed", err.Error())
+			return
+		}
+	}
+
+	for _, attachment := range rendered.Attachments {
+		if diags := r.uploadAttachment(ctx, issueKey, baseDir, attachment); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	data.IssueKey = types.StringValue(issueKey)
+	data.Summary = types.StringValue(spec.Summary)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MarkdownIssueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MarkdownIssueModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	issue, err := r.client.GetIssue(ctx, data.IssueKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Reading Jira Issue Failed", err.Error())
+		return
+	}
+
+	spec, _, diags := r.loadSpec(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rendered, err := markdown.Render(spec.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Markdown Conversion Failed", err.Error())
+		return
+	}
+
+	// Only let the summary drift the plan when the locally rendered ADF
+	// actually disagrees with what Jira normalized it to; pure whitespace
+	// differences introduced by Jira's own formatter should not churn the
+	// plan on every apply.
+	if !markdown.EqualADF(rendered.Doc, issue.Fields.Description) {
+		data.Summary = types.StringValue(issue.Fields.Summary)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MarkdownIssueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MarkdownIssueModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state MarkdownIssueModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spec, baseDir, diags := r.loadSpec(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rendered, err := markdown.Render(spec.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Markdown Conversion Failed", err.Error())
+		return
+	}
+
+	fields := client.IssueFields{
+		Summary:      spec.Summary,
+		Description:  rendered.Doc,
+		Labels:       spec.Labels,
+		Project:      client.IssueRef{Key: spec.Project},
+		IssueType:    client.IssueRef{Name: spec.IssueType},
+		EpicLink:     spec.EpicLink,
+		CustomFields: spec.CustomFields,
+	}
+	if spec.Assignee != "" {
+		fields.Assignee = &client.IssueRef{AccountID: spec.Assignee}
+	}
+
+	issueKey := state.IssueKey.ValueString()
+
+	if err := r.client.UpdateIssue(ctx, issueKey, fields); err != nil {
+		resp.Diagnostics.AddError("Updating Jira Issue Failed", err.Error())
+		return
+	}
+
+	if diags := r.resyncSubtasksAndAttachments(ctx, issueKey, baseDir, fields.Project, rendered); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	data.IssueKey = state.IssueKey
+	data.Summary = types.StringValue(spec.Summary)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resyncSubtasksAndAttachments diffs the document's rendered subtasks and
+// attachments against what the issue already has in Jira and creates or
+// uploads whatever is missing. It never deletes: a subtask or attachment
+// removed from the markdown source is left in place rather than destroyed
+// out from under the issue.
+func (r *MarkdownIssueResource) resyncSubtasksAndAttachments(ctx context.Context, issueKey, baseDir string, project client.IssueRef, rendered markdown.Rendered) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(rendered.Subtasks) == 0 && len(rendered.Attachments) == 0 {
+		return diags
+	}
+
+	issue, err := r.client.GetIssue(ctx, issueKey)
+	if err != nil {
+		diags.AddError("Reading Jira Issue Failed", err.Error())
+		return diags
+	}
+
+	existingSubtasks := make(map[string]bool, len(issue.Fields.Subtasks))
+	for _, s := range issue.Fields.Subtasks {
+		existingSubtasks[s.Fields.Summary] = true
+	}
+
+	for _, subtask := range rendered.Subtasks {
+		if existingSubtasks[subtask.Summary] {
+			continue
+		}
+		if _, err := r.client.CreateSubtask(ctx, issueKey, client.IssueFields{
+			Summary: subtask.Summary,
+			Project: project,
+		}); err != nil {
+			diags.AddError("Creating Subtask Failed", err.Error())
+			return diags
+		}
+	}
+
+	existingAttachments := make(map[string]bool, len(issue.Fields.Attachments))
+	for _, a := range issue.Fields.Attachments {
+		existingAttachments[a.Filename] = true
+	}
+
+	for _, attachment := range rendered.Attachments {
+		if existingAttachments[filepath.Base(attachment.Path)] {
+			continue
+		}
+		if diags2 := r.uploadAttachment(ctx, issueKey, baseDir, attachment); diags2.HasError() {
+			diags.Append(diags2...)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (r *MarkdownIssueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MarkdownIssueModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteIssue(ctx, data.IssueKey.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Deleting Jira Issue Failed", err.Error())
+	}
+}
+
+// loadSpec reads and parses the configured markdown document, applying any
+// resource-level overrides (project, issuetype, assignee, ...) on top of
+// whatever front matter set. It returns the directory the document lives
+// in (for resolving relative image paths), empty for inline content.
+func (r *MarkdownIssueResource) loadSpec(ctx context.Context, data MarkdownIssueModel) (markdown.IssueSpec, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var baseDir string
+	var raw []byte
+
+	switch {
+	case !data.Path.IsNull() && data.Path.ValueString() != "":
+		p := data.Path.ValueString()
+		baseDir = filepath.Dir(p)
+
+		b, err := os.ReadFile(p)
+		if err != nil {
+			diags.AddError("Reading Markdown File Failed", err.Error())
+			return markdown.IssueSpec{}, "", diags
+		}
+		raw = b
+	case !data.Content.IsNull():
+		raw = []byte(data.Content.ValueString())
+	default:
+		diags.AddError("Invalid Configuration", "one of `path` or `content` must be set")
+		return markdown.IssueSpec{}, "", diags
+	}
+
+	spec, err := markdown.ParseDocument(raw)
+	if err != nil {
+		diags.AddError("Parsing Markdown Document Failed", err.Error())
+		return markdown.IssueSpec{}, "", diags
+	}
+
+	if v := data.Project.ValueString(); v != "" {
+		spec.Project = v
+	}
+	if v := data.IssueType.ValueString(); v != "" {
+		spec.IssueType = v
+	}
+	if v := data.Assignee.ValueString(); v != "" {
+		spec.Assignee = v
+	}
+	if v := data.EpicLink.ValueString(); v != "" {
+		spec.EpicLink = v
+	}
+
+	if !data.Labels.IsNull() {
+		var labels []string
+		diags.Append(data.Labels.ElementsAs(ctx, &labels, false)...)
+		spec.Labels = append(spec.Labels, labels...)
+	}
+
+	return spec, baseDir, diags
+}
+
+func (r *MarkdownIssueResource) uploadAttachment(ctx context.Context, issueKey, baseDir string, attachment markdown.Attachment) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	f, err := os.Open(filepath.Join(baseDir, attachment.Path))
+	if err != nil {
+		diags.AddError("Opening Attachment Failed", err.Error())
+		return diags
+	}
+	defer f.Close()
+
+	if err := r.client.UploadAttachment(ctx, issueKey, attachment.Path, f); err != nil {
+		diags.AddError("Uploading Attachment Failed", err.Error())
+	}
+
+	return diags
+}
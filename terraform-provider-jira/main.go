@@ -9,11 +9,21 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+
 	"github.com/md2jira/terraform-provider-jira/internal/provider"
+	"github.com/md2jira/terraform-provider-jira/internal/sdkv2provider"
 )
 
-// version is set during build via -ldflags
-var version = "dev"
+// version and frameworkVersion are set during build via -ldflags, e.g.
+// -ldflags "-X main.version=1.2.3 -X main.frameworkVersion=1.5.0".
+var (
+	version          = "dev"
+	frameworkVersion = "unknown"
+)
 
 func main() {
 	var debug bool
@@ -21,14 +31,40 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		Address: "registry.terraform.io/md2jira/jira",
-		Debug:   debug,
+	ctx := context.Background()
+
+	// The SDKv2 provider only speaks protocol v5; upgrade it to v6 so it can
+	// be muxed with the terraform-plugin-framework provider below. This lets
+	// complex Jira resources (workflows, screens, custom fields) be ported
+	// to the Framework one at a time instead of all at once.
+	upgradedSDKProvider, err := tf5to6server.UpgradeServer(ctx, sdkv2provider.New(version)().GRPCProvider)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	providers := []func() tfprotov6.ProviderServer{
+		providerserver.NewProtocol6(provider.New(version, frameworkVersion)()),
+		func() tfprotov6.ProviderServer {
+			return upgradedSDKProvider
+		},
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
-}
 
+	var serveOpts []tf6server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
+	}
+
+	err = tf6server.Serve(
+		"registry.terraform.io/md2jira/jira",
+		muxServer.ProviderServer,
+		serveOpts...,
+	)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+}